@@ -0,0 +1,32 @@
+// Package cmdctx carries the dependencies that command constructors
+// need — the API client, auth manager, config, and I/O streams — so
+// commands stop reaching into cmd/neona package-level globals and can
+// be driven with injected readers/writers in tests.
+package cmdctx
+
+import (
+	"io"
+
+	"github.com/fentz26/neona/internal/apiclient"
+	"github.com/fentz26/neona/internal/auth"
+)
+
+// Config holds CLI-wide settings that come from persistent flags, as
+// opposed to per-command ones.
+type Config struct {
+	// APIAddr is the daemon address passed via the root --api flag.
+	APIAddr string
+}
+
+// Context is threaded into the NewXxxCmd constructors (except
+// NewCompletionCmd, which takes the root command). Auth is left
+// nil until a command that actually needs authentication builds it
+// (see the auth package's managerFor), so commands that don't touch
+// credentials never pay for that setup.
+type Context struct {
+	API    *apiclient.Client
+	Auth   *auth.Manager
+	Config Config
+	Out    io.Writer
+	In     io.Reader
+}
@@ -0,0 +1,336 @@
+// Package auth wires up `neona auth` and its subcommands: login,
+// logout, whoami, migrate-store, and export.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/fentz26/neona/internal/auth"
+	"github.com/spf13/cobra"
+)
+
+// NewAuthCmd builds the `neona auth` command group.
+func NewAuthCmd(ctx *cmdctx.Context) *cobra.Command {
+	authCmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage authentication",
+		Long:  `Manage authentication with your Neona account.`,
+	}
+
+	var (
+		tokenFlag           string
+		deviceFlag          bool
+		credentialStoreFlag string
+	)
+
+	registerFlags := func(c *cobra.Command, withDevice bool) {
+		c.Flags().StringVar(&tokenFlag, "token", "", "Authenticate using a token JSON string (alternative to browser flow)")
+		// --token takes an opaque JSON blob, not something worth
+		// completing from the filesystem.
+		c.RegisterFlagCompletionFunc("token", cobra.NoFileCompletions)
+		if withDevice {
+			c.Flags().BoolVar(&deviceFlag, "device", wantsDeviceFlow(), "Use the OAuth device authorization flow instead of a browser callback")
+		}
+	}
+
+	loginCmd := &cobra.Command{
+		Use:   "login",
+		Short: "Sign in to your Neona account",
+		Long: `Sign in to your Neona account using browser-based OAuth.
+
+This will open your default browser to complete the authentication flow.
+With --device (the default when no display is available or over SSH),
+it instead prints a code to enter at a verification URL on another device.
+Once authenticated, your CLI will be connected to your Neona account.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogin(cmd, ctx, credentialStoreFlag, tokenFlag, deviceFlag)
+		},
+	}
+	registerFlags(loginCmd, true)
+
+	logoutCmd := &cobra.Command{
+		Use:          "logout",
+		Short:        "Sign out of your Neona account",
+		Long:         `Sign out and remove stored credentials.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogout(cmd, ctx, credentialStoreFlag)
+		},
+	}
+
+	whoamiCmd := &cobra.Command{
+		Use:          "whoami",
+		Short:        "Display current user information",
+		Long:         `Show information about the currently authenticated user.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhoami(cmd, ctx, credentialStoreFlag)
+		},
+	}
+
+	migrateStoreCmd := &cobra.Command{
+		Use:   "migrate-store <file|keyring|none>",
+		Short: "Move the stored session to a different credential store",
+		Long: `Re-persist the current session into a different credential store backend
+and remove it from the current one.`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrateStore(cmd, ctx, credentialStoreFlag, args[0])
+		},
+	}
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Print the current session as a token JSON string",
+		Long: `Print the current session in the same JSON shape accepted by
+'neona auth login --token', e.g. to copy credentials to another machine.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(cmd, ctx, credentialStoreFlag)
+		},
+	}
+
+	authCmd.PersistentFlags().StringVar(&credentialStoreFlag, "credential-store", "", "Credential storage backend: file, keyring, or none (default: auto-detect)")
+	authCmd.AddCommand(loginCmd, logoutCmd, whoamiCmd, migrateStoreCmd, exportCmd)
+
+	return authCmd
+}
+
+// NewDirectLoginCmd builds a standalone `neona login` alias for the
+// `neona auth login` command, registered directly on the root command
+// for convenience.
+func NewDirectLoginCmd(ctx *cmdctx.Context) *cobra.Command {
+	var tokenFlag string
+	var deviceFlag bool
+	var credentialStoreFlag string
+
+	cmd := &cobra.Command{
+		Use:          "login",
+		Short:        "Sign in to your Neona account",
+		Long:         `Sign in to your Neona account using browser-based OAuth.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogin(cmd, ctx, credentialStoreFlag, tokenFlag, deviceFlag)
+		},
+	}
+	cmd.Flags().StringVar(&tokenFlag, "token", "", "Authenticate using a token JSON string (alternative to browser flow)")
+	cmd.RegisterFlagCompletionFunc("token", cobra.NoFileCompletions)
+	cmd.Flags().BoolVar(&deviceFlag, "device", wantsDeviceFlow(), "Use the OAuth device authorization flow instead of a browser callback")
+	cmd.Flags().StringVar(&credentialStoreFlag, "credential-store", "", "Credential storage backend: file, keyring, or none (default: auto-detect)")
+	return cmd
+}
+
+// NewDirectLogoutCmd builds a standalone `neona logout` alias for the
+// `neona auth logout` command.
+func NewDirectLogoutCmd(ctx *cmdctx.Context) *cobra.Command {
+	var credentialStoreFlag string
+
+	cmd := &cobra.Command{
+		Use:          "logout",
+		Short:        "Sign out of your Neona account",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogout(cmd, ctx, credentialStoreFlag)
+		},
+	}
+	cmd.Flags().StringVar(&credentialStoreFlag, "credential-store", "", "Credential storage backend: file, keyring, or none (default: auto-detect)")
+	return cmd
+}
+
+// wantsDeviceFlow reports whether the browser-callback flow is likely
+// to fail: no X11/Wayland DISPLAY, or an active SSH session.
+func wantsDeviceFlow() bool {
+	if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		return true
+	}
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// managerFor returns ctx.Auth, building and caching it on ctx the
+// first time an auth subcommand actually needs it. This keeps the
+// credential-store setup (a keyring round trip, or reading a session
+// file that may be corrupt) scoped to commands that touch
+// authentication, instead of paying for it on every CLI invocation.
+func managerFor(ctx *cmdctx.Context, credentialStore string) (*auth.Manager, error) {
+	if ctx.Auth != nil {
+		return ctx.Auth, nil
+	}
+
+	manager, err := auth.NewManagerWithStore(credentialStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize auth: %w", err)
+	}
+	ctx.Auth = manager
+	return manager, nil
+}
+
+func runLogin(cmd *cobra.Command, ctx *cmdctx.Context, credentialStore, token string, device bool) error {
+	manager, err := managerFor(ctx, credentialStore)
+	if err != nil {
+		return err
+	}
+
+	if manager.IsAuthenticated() {
+		user := manager.GetUser()
+		cmd.Printf("└  Already signed in as %s (%s)\n", user.Username, user.Email)
+		cmd.Println()
+		cmd.Println("   Use 'neona logout' to sign out, then 'neona auth login' to re-authenticate.")
+		return nil
+	}
+
+	if token != "" {
+		cmd.Println("┌  Authenticating with token...")
+		session, err := manager.LoginWithToken(token)
+		if err != nil {
+			cmd.Println("└  ✗ Authentication failed")
+			return fmt.Errorf("token authentication failed: %w", err)
+		}
+
+		cmd.Println("│")
+		cmd.Printf("└  ✓ Signed in as %s (%s)\n", session.User.Username, session.User.Email)
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cmd.Println("│")
+		cmd.Println("└  Authentication cancelled.")
+		cancel()
+	}()
+
+	var session *auth.Session
+	if device {
+		cmd.Println("┌  Starting device authorization...")
+		session, err = manager.LoginWithDevice(runCtx, func(line string) { cmd.Print(line) })
+	} else {
+		cmd.Println("┌  Opening browser for authentication...")
+		cmd.Println("│  Please complete the sign-in process in your browser.")
+		cmd.Println("│")
+		cmd.Println("│  Waiting for authentication... (Press Ctrl+C to cancel)")
+		cmd.Println("│")
+		session, err = manager.Login(runCtx)
+	}
+	if err != nil {
+		if runCtx.Err() != nil {
+			return nil // User cancelled
+		}
+		cmd.Println("└  ✗ Authentication failed")
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	cmd.Printf("└  ✓ Signed in as %s (%s)\n", session.User.Username, session.User.Email)
+	return nil
+}
+
+func runLogout(cmd *cobra.Command, ctx *cmdctx.Context, credentialStore string) error {
+	manager, err := managerFor(ctx, credentialStore)
+	if err != nil {
+		return err
+	}
+
+	if !manager.IsAuthenticated() {
+		cmd.Println("You are not currently signed in.")
+		return nil
+	}
+
+	user := manager.GetUser()
+	if err := manager.Logout(); err != nil {
+		return fmt.Errorf("failed to sign out: %w", err)
+	}
+
+	cmd.Printf("✓ Signed out from %s\n", user.Username)
+	return nil
+}
+
+func runWhoami(cmd *cobra.Command, ctx *cmdctx.Context, credentialStore string) error {
+	manager, err := managerFor(ctx, credentialStore)
+	if err != nil {
+		return err
+	}
+
+	if !manager.IsAuthenticated() {
+		cmd.Println("Not signed in.")
+		cmd.Println()
+		cmd.Println("Use 'neona login' to sign in to your Neona account.")
+		return nil
+	}
+
+	user := manager.GetUser()
+	session := manager.GetSession()
+
+	cmd.Println("┌────────────────────────────────────────────────┐")
+	cmd.Println("│              Current User                      │")
+	cmd.Println("├────────────────────────────────────────────────┤")
+	cmd.Printf("│  Username: %-35s │\n", truncateString(user.Username, 35))
+	cmd.Printf("│  Email:    %-35s │\n", truncateString(user.Email, 35))
+	cmd.Printf("│  User ID:  %-35s │\n", truncateString(user.ID[:8]+"...", 35))
+	cmd.Println("└────────────────────────────────────────────────┘")
+
+	if session != nil && session.ExpiresAt > 0 {
+		cmd.Println()
+		cmd.Printf("Session expires: %s\n", formatExpiry(session.ExpiresAt))
+	}
+
+	return nil
+}
+
+func runMigrateStore(cmd *cobra.Command, ctx *cmdctx.Context, credentialStore, destKind string) error {
+	manager, err := managerFor(ctx, credentialStore)
+	if err != nil {
+		return err
+	}
+
+	dest, err := auth.NewCredentialStore(destKind)
+	if err != nil {
+		return err
+	}
+
+	fromName := manager.StoreName()
+	if err := manager.MigrateStore(dest); err != nil {
+		return err
+	}
+
+	cmd.Printf("✓ Migrated session from %s to %s\n", fromName, dest.Name())
+	return nil
+}
+
+func runExport(cmd *cobra.Command, ctx *cmdctx.Context, credentialStore string) error {
+	manager, err := managerFor(ctx, credentialStore)
+	if err != nil {
+		return err
+	}
+
+	data, err := manager.Export()
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(data))
+	return nil
+}
+
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
+
+func formatExpiry(expiresAt int64) string {
+	if expiresAt == 0 {
+		return "unknown"
+	}
+	return fmt.Sprintf("Unix timestamp %d", expiresAt)
+}
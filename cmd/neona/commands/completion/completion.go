@@ -0,0 +1,116 @@
+// Package completion wires up `neona completion`, which emits shell
+// completion scripts for bash, zsh, fish, and PowerShell, and can
+// install them for bash, zsh, and fish.
+package completion
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCompletionCmd builds the `neona completion` command. root is the
+// command tree to generate completions for (normally the actual root
+// command, passed in by main so this package doesn't need to import
+// it and create a cycle).
+func NewCompletionCmd(root *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate shell completion scripts",
+		Long: `Generate a shell completion script for neona.
+
+Load it for the current session, e.g. for bash:
+
+  source <(neona completion bash)
+
+Or install it permanently with:
+
+  neona completion install`,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		DisableFlagsInUseLine: true,
+		SilenceUsage:          true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return generate(root, cmd.OutOrStdout(), args[0])
+		},
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:          "install",
+		Short:        "Install the completion script for your current shell",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return install(root, cmd)
+		},
+	})
+
+	return cmd
+}
+
+func generate(root *cobra.Command, w io.Writer, shell string) error {
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(w, true)
+	case "zsh":
+		return root.GenZshCompletion(w)
+	case "fish":
+		return root.GenFishCompletion(w, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell %q", shell)
+	}
+}
+
+func install(root *cobra.Command, cmd *cobra.Command) error {
+	shell := filepath.Base(os.Getenv("SHELL"))
+	path, instructions, ok := installTarget(shell)
+	if !ok {
+		cmd.Printf("Don't know how to auto-install completions for shell %q.\n", shell)
+		cmd.Println("Generate a script with 'neona completion <shell>' and source it from your shell's rc file instead.")
+		return nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		cmd.Printf("Could not write to %s: %v\n", path, err)
+		cmd.Println(instructions)
+		return nil
+	}
+	defer f.Close()
+
+	if err := generate(root, f, shell); err != nil {
+		return err
+	}
+
+	cmd.Printf("Installed %s completions to %s\n", shell, path)
+	cmd.Println(instructions)
+	return nil
+}
+
+// installTarget returns the conventional per-shell completion script
+// location and a one-line reminder of how to make the shell load it.
+func installTarget(shell string) (path string, instructions string, ok bool) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", "", false
+	}
+
+	switch shell {
+	case "bash":
+		if runtime.GOOS == "darwin" {
+			return filepath.Join(homeDir, ".bash_completion.d", "neona"), "Restart your shell, or 'source ~/.bash_completion.d/neona'.", true
+		}
+		return "/etc/bash_completion.d/neona", "Restart your shell to pick up the new completions.", true
+	case "zsh":
+		return filepath.Join(homeDir, ".zsh", "completions", "_neona"), "Ensure that directory is on your $fpath, then restart your shell.", true
+	case "fish":
+		return filepath.Join(homeDir, ".config", "fish", "completions", "neona.fish"), "Restart your shell to pick up the new completions.", true
+	default:
+		return "", "", false
+	}
+}
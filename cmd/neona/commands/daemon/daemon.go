@@ -0,0 +1,132 @@
+// Package daemon wires up `neona daemon`, which starts the Neona
+// control-plane API server (neonad).
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/fentz26/neona/internal/audit"
+	"github.com/fentz26/neona/internal/auth"
+	"github.com/fentz26/neona/internal/connectors/localexec"
+	"github.com/fentz26/neona/internal/controlplane"
+	"github.com/fentz26/neona/internal/logging"
+	"github.com/fentz26/neona/internal/store"
+	"github.com/spf13/cobra"
+)
+
+// NewDaemonCmd builds the `neona daemon` command. It does not use ctx
+// today (the daemon owns its own store/service wiring rather than
+// talking to another daemon's API), but takes one for constructor
+// consistency with the other command packages.
+func NewDaemonCmd(ctx *cmdctx.Context) *cobra.Command {
+	var (
+		listenAddr      string
+		dbPath          string
+		shutdownTimeout time.Duration
+	)
+
+	homeDir, _ := os.UserHomeDir()
+	defaultDB := filepath.Join(homeDir, ".neona", "neona.db")
+
+	cmd := &cobra.Command{
+		Use:          "daemon",
+		Short:        "Start the Neona daemon (neonad)",
+		Long:         `Starts the Neona daemon which provides the HTTP API for task coordination.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDaemon(listenAddr, dbPath, shutdownTimeout)
+		},
+	}
+
+	cmd.Flags().StringVar(&listenAddr, "listen", "127.0.0.1:7466", "Listen address for the API server")
+	cmd.Flags().StringVar(&dbPath, "db", defaultDB, "Path to the daemon's state file")
+	cmd.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", 30*time.Second, "Time to wait for connections to drain and in-flight tasks to checkpoint on shutdown")
+
+	return cmd
+}
+
+func runDaemon(listenAddr, dbPath string, shutdownTimeout time.Duration) error {
+	// logHub retains recent log records and fans them out live, backing
+	// the /logs/stream endpoint for `neona log -f --api`.
+	logHub := logging.NewHub(1000)
+
+	logger, err := logging.NewWithHub("daemon", logHub)
+	if err != nil {
+		return err
+	}
+	defer logger.Close()
+
+	logger.Info("starting Neona daemon", logging.WithComponent("daemon"))
+
+	s, err := store.New(dbPath)
+	if err != nil {
+		return err
+	}
+
+	pdr := audit.NewPDRWriter(s)
+	if cp, err := pdr.RestoreInFlight(); err != nil {
+		logger.Error(fmt.Sprintf("failed to read previous checkpoint: %v", err), logging.WithComponent("startup"))
+	} else if cp != nil && len(cp.TaskIDs) > 0 {
+		logger.Info(fmt.Sprintf("recovered %d in-flight task(s) from previous run: %v", len(cp.TaskIDs), cp.TaskIDs), logging.WithComponent("startup"))
+	}
+
+	workDir, _ := os.Getwd()
+	connector := localexec.New(workDir)
+
+	service := controlplane.NewService(s, pdr, connector)
+	server := controlplane.NewServer(service, listenAddr)
+	server.Handle("/logs/stream", controlplane.NewLogStreamHandler(logHub))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	authMgr, err := auth.NewManager()
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to initialize auth for background refresh: %v", err), logging.WithComponent("daemon"))
+	} else if authMgr.IsAuthenticated() {
+		// Keeps the daemon's own session from expiring mid-task across
+		// the lifetime of this long-running process; stops when ctx is
+		// cancelled at shutdown.
+		authMgr.StartBackgroundRefresh(ctx)
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() { serveErrCh <- server.Start() }()
+
+	select {
+	case err := <-serveErrCh:
+		s.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Info("received shutdown signal, draining connections", logging.WithComponent("shutdown"))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Server.Shutdown drains in-flight HTTP requests, checkpoints
+	// in-flight task state through the audit.PDRWriter so a restart can
+	// resume, then has the localexec connector SIGTERM (then SIGKILL
+	// after a grace period) any still-running child processes — all
+	// bounded by shutdownCtx.
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error(fmt.Sprintf("shutdown did not complete cleanly: %v", err), logging.WithComponent("shutdown"))
+	} else {
+		logger.Info("connections drained and tasks checkpointed", logging.WithComponent("shutdown"))
+	}
+
+	if err := s.Close(); err != nil {
+		return fmt.Errorf("failed to close store: %w", err)
+	}
+
+	logger.Info("shutdown complete", logging.WithComponent("shutdown"))
+	return nil
+}
@@ -0,0 +1,383 @@
+// Package log wires up `neona log`, which shows the Neona daemon's
+// structured log output.
+package log
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/fentz26/neona/internal/logging"
+	"github.com/spf13/cobra"
+)
+
+// NewLogCmd builds the `neona log` command.
+func NewLogCmd(ctx *cmdctx.Context) *cobra.Command {
+	var (
+		follow  bool
+		lines   int
+		service string
+		level   string
+		since   string
+		task    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "Show Neona daemon logs",
+		Long: `Display the Neona daemon logs to check for errors and debug issues.
+
+By default, shows the last 50 lines from the daemon log file.
+Use --follow (-f) to continuously stream new log entries. With -f this
+prefers live streaming from the daemon's /logs/stream API (so it also
+works against a remote daemon via --api), falling back to tailing the
+local log file when the daemon can't be reached.
+
+Examples:
+  neona log                    # Show last 50 lines
+  neona log -n 100             # Show last 100 lines
+  neona log -f                 # Follow/tail the log
+  neona log --level error      # Show only error logs
+  neona log --since 10m        # Show entries from the last 10 minutes
+  neona log --task abc123      # Show entries for a specific task`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := filterOptions{level: level, service: service, task: task}
+			return runLog(cmd, ctx, follow, lines, since, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Follow log output (like tail -f)")
+	cmd.Flags().IntVarP(&lines, "lines", "n", 50, "Number of lines to show")
+	cmd.Flags().StringVar(&service, "service", "", "Filter by service (daemon, scheduler, mcp)")
+	cmd.Flags().StringVar(&level, "level", "", "Filter by level (error, warning, info)")
+	cmd.Flags().StringVar(&since, "since", "", "Only show entries newer than this duration (e.g. 10m, 1h)")
+	cmd.Flags().StringVar(&task, "task", "", "Filter by task ID")
+	cmd.RegisterFlagCompletionFunc("service", completeServices(ctx))
+
+	return cmd
+}
+
+// completeServices asks the daemon which services are currently
+// registered, for `neona log --service <TAB>`.
+func completeServices(ctx *cmdctx.Context) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		resp, err := ctx.API.Get("/services")
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var services []string
+		if err := json.Unmarshal(resp, &services); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return services, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+type filterOptions struct {
+	level   string
+	service string
+	task    string
+}
+
+func runLog(cmd *cobra.Command, ctx *cmdctx.Context, follow bool, lines int, since string, opts filterOptions) error {
+	logPath, err := logging.LogPath()
+	if err != nil {
+		return err
+	}
+
+	if follow {
+		return followLog(cmd, ctx, logPath, since, opts)
+	}
+
+	if _, err := os.Stat(logPath); os.IsNotExist(err) {
+		cmd.Printf("📋 Log file not found at: %s\n\n", logPath)
+		cmd.Println("The daemon may be logging to stdout/stderr. To capture logs:")
+		cmd.Println("")
+		cmd.Println("  1. Run 'neona daemon' in a terminal to see live output")
+		cmd.Println("  2. Or redirect output: neona daemon > ~/.neona/neona.log 2>&1 &")
+		cmd.Println("")
+		cmd.Println("If running via systemd, check with: journalctl -u neona")
+		return nil
+	}
+
+	cutoff, err := sinceCutoff(since)
+	if err != nil {
+		return err
+	}
+	return showRecentLogs(cmd, logPath, lines, cutoff, opts)
+}
+
+// followLog streams live log records from the daemon's /logs/stream
+// endpoint when reachable, reconnecting with a backoff and resuming
+// from the last record ID seen so a dropped connection doesn't lose
+// records in between. If the daemon can't be reached at all on the
+// first attempt, it falls back to tailing the local log file.
+func followLog(cmd *cobra.Command, ctx *cmdctx.Context, logPath string, since string, opts filterOptions) error {
+	params := streamParams(since, opts)
+
+	var lastID uint64
+	receivedAny := false
+	for attempt := 0; ; attempt++ {
+		err := ctx.API.StreamLogs(context.Background(), params, lastID, func(rec logging.Record) {
+			receivedAny = true
+			lastID = rec.ID
+			printRecord(cmd, rec)
+		})
+		if err == nil {
+			return nil
+		}
+
+		if attempt == 0 && !receivedAny {
+			cmd.Printf("📋 Daemon log stream unavailable (%v), falling back to local file tail.\n", err)
+			cutoff, cerr := sinceCutoff(since)
+			if cerr != nil {
+				return cerr
+			}
+			return tailLog(cmd, logPath, cutoff, opts)
+		}
+
+		cmd.Printf("⚠️  Log stream disconnected (%v), reconnecting...\n", err)
+		time.Sleep(backoffDelay(attempt))
+	}
+}
+
+func streamParams(since string, opts filterOptions) url.Values {
+	params := url.Values{}
+	if opts.level != "" {
+		params.Set("level", opts.level)
+	}
+	if opts.service != "" {
+		params.Set("service", opts.service)
+	}
+	if opts.task != "" {
+		params.Set("task", opts.task)
+	}
+	if since != "" {
+		params.Set("since", since)
+	}
+	return params
+}
+
+// backoffDelay returns the reconnect delay for the given 0-indexed
+// attempt, doubling from 1s up to a 30s ceiling.
+func backoffDelay(attempt int) time.Duration {
+	capped := attempt
+	if capped > 5 {
+		capped = 5
+	}
+	d := time.Second << uint(capped)
+	if d > 30*time.Second {
+		d = 30 * time.Second
+	}
+	return d
+}
+
+func sinceCutoff(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	d, err := time.ParseDuration(since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since duration %q: %w", since, err)
+	}
+	return time.Now().Add(-d), nil
+}
+
+func showRecentLogs(cmd *cobra.Command, logPath string, lines int, cutoff time.Time, opts filterOptions) error {
+	file, err := os.Open(logPath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	var kept []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if shouldShowLine(line, cutoff, opts) {
+			kept = append(kept, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading log file: %w", err)
+	}
+
+	start := 0
+	if len(kept) > lines {
+		start = len(kept) - lines
+	}
+
+	if len(kept) == 0 {
+		cmd.Println("📋 No log entries found")
+		if opts.level != "" {
+			cmd.Printf("   (filtered by level: %s)\n", opts.level)
+		}
+		return nil
+	}
+
+	cmd.Printf("📋 Showing last %d log entries from %s\n", min(lines, len(kept)), logPath)
+	cmd.Println(strings.Repeat("─", 60))
+
+	for i := start; i < len(kept); i++ {
+		printLogLine(cmd, kept[i])
+	}
+
+	cmd.Println(strings.Repeat("─", 60))
+	cmd.Printf("📊 Total: %d entries shown\n", len(kept)-start)
+	return nil
+}
+
+func tailLog(cmd *cobra.Command, logPath string, cutoff time.Time, opts filterOptions) error {
+	file, err := os.Open(logPath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(0, 2); err != nil {
+		return fmt.Errorf("failed to seek to end of file: %w", err)
+	}
+
+	cmd.Printf("📋 Following log file: %s (Ctrl+C to stop)\n", logPath)
+	cmd.Println(strings.Repeat("─", 60))
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		if shouldShowLine(line, cutoff, opts) {
+			printLogLine(cmd, line)
+		}
+	}
+}
+
+// parseRecord attempts to decode a line as a structured logging.Record.
+// The bool reports whether decoding succeeded; legacy plain-text lines
+// fall back to the colorized raw-line rendering.
+func parseRecord(line string) (logging.Record, bool) {
+	var rec logging.Record
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || trimmed[0] != '{' {
+		return rec, false
+	}
+	if err := json.Unmarshal([]byte(trimmed), &rec); err != nil {
+		return rec, false
+	}
+	return rec, true
+}
+
+func shouldShowLine(line string, cutoff time.Time, opts filterOptions) bool {
+	rec, ok := parseRecord(line)
+	if !ok {
+		return shouldShowRawLine(line, opts)
+	}
+
+	if opts.level != "" && !logging.LevelMatches(rec.Level, opts.level) {
+		return false
+	}
+	if opts.service != "" && !strings.EqualFold(rec.Service, opts.service) {
+		return false
+	}
+	if opts.task != "" && rec.TaskID != opts.task {
+		return false
+	}
+	if !cutoff.IsZero() && rec.Time.Before(cutoff) {
+		return false
+	}
+	return true
+}
+
+// shouldShowRawLine preserves the legacy grep-based filtering for plain
+// (non-JSON) log lines, e.g. output predating the logging subsystem or
+// from third-party processes writing straight to the file.
+func shouldShowRawLine(line string, opts filterOptions) bool {
+	lowerLine := strings.ToLower(line)
+
+	if opts.level != "" {
+		switch strings.ToLower(opts.level) {
+		case "error":
+			if !strings.Contains(lowerLine, "error") && !strings.Contains(lowerLine, "fatal") {
+				return false
+			}
+		case "warning", "warn":
+			if !strings.Contains(lowerLine, "warn") && !strings.Contains(lowerLine, "error") && !strings.Contains(lowerLine, "fatal") {
+				return false
+			}
+		}
+	}
+
+	if opts.service != "" && !strings.Contains(lowerLine, strings.ToLower(opts.service)) {
+		return false
+	}
+	if opts.task != "" {
+		return false
+	}
+	return true
+}
+
+func printLogLine(cmd *cobra.Command, line string) {
+	rec, ok := parseRecord(line)
+	if !ok {
+		printColoredLog(cmd, line)
+		return
+	}
+	printRecord(cmd, rec)
+}
+
+// printRecord renders a single structured log record, colorized by
+// level. It's shared by file-based rendering (via printLogLine) and
+// records received directly from the streaming API.
+func printRecord(cmd *cobra.Command, rec logging.Record) {
+	ts := rec.Time.Format("15:04:05")
+	prefix := fmt.Sprintf("%s [%s] %s", ts, strings.ToUpper(string(rec.Level)), rec.Service)
+	if rec.Component != "" {
+		prefix += "/" + rec.Component
+	}
+	if rec.TaskID != "" {
+		prefix += " task=" + rec.TaskID
+	}
+
+	formatted := fmt.Sprintf("%s: %s", prefix, rec.Msg)
+	switch rec.Level {
+	case logging.LevelError:
+		cmd.Printf("\033[31m%s\033[0m\n", formatted)
+	case logging.LevelWarn:
+		cmd.Printf("\033[33m%s\033[0m\n", formatted)
+	default:
+		cmd.Println(formatted)
+	}
+}
+
+func printColoredLog(cmd *cobra.Command, line string) {
+	lowerLine := strings.ToLower(line)
+
+	if strings.Contains(lowerLine, "error") || strings.Contains(lowerLine, "fatal") {
+		cmd.Printf("\033[31m%s\033[0m\n", line)
+	} else if strings.Contains(lowerLine, "warn") {
+		cmd.Printf("\033[33m%s\033[0m\n", line)
+	} else if strings.Contains(lowerLine, "success") || strings.Contains(lowerLine, "started") || strings.Contains(lowerLine, "complete") {
+		cmd.Printf("\033[32m%s\033[0m\n", line)
+	} else {
+		cmd.Println(line)
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,178 @@
+// Package memory wires up `neona memory` and its subcommands for
+// adding and querying memory items stored by the daemon.
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/spf13/cobra"
+)
+
+// NewMemoryCmd builds the `neona memory` command group.
+func NewMemoryCmd(ctx *cmdctx.Context) *cobra.Command {
+	memoryCmd := &cobra.Command{
+		Use:   "memory",
+		Short: "Manage memory items",
+	}
+
+	var (
+		content   string
+		tags      string
+		taskID    string
+		query     string
+		queryTags string
+	)
+
+	addCmd := &cobra.Command{
+		Use:          "add",
+		Short:        "Add a memory item",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMemoryAdd(cmd, ctx, content, tags, taskID)
+		},
+	}
+	addCmd.Flags().StringVar(&content, "content", "", "Memory content (required)")
+	addCmd.Flags().StringVar(&tags, "tags", "", "Comma-separated tags")
+	addCmd.Flags().StringVar(&taskID, "task", "", "Associated task ID")
+	addCmd.MarkFlagRequired("content")
+	addCmd.RegisterFlagCompletionFunc("tags", completeTags(ctx))
+
+	queryCmd := &cobra.Command{
+		Use:          "query",
+		Short:        "Query memory items",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMemoryQuery(cmd, ctx, query, queryTags)
+		},
+	}
+	queryCmd.Flags().StringVar(&query, "q", "", "Search query")
+	queryCmd.Flags().StringVar(&queryTags, "tags", "", "Filter by comma-separated tags")
+	queryCmd.RegisterFlagCompletionFunc("tags", completeTags(ctx))
+
+	memoryCmd.AddCommand(addCmd, queryCmd)
+	return memoryCmd
+}
+
+// completeTags suggests tags already in use, for `neona memory query
+// --tags <TAB>` and `neona memory add --tags <TAB>`.
+func completeTags(ctx *cmdctx.Context) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		resp, err := ctx.API.Get("/memory")
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var items []map[string]interface{}
+		if err := json.Unmarshal(resp, &items); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		seen := map[string]bool{}
+		var tags []string
+		for _, item := range items {
+			raw, ok := item["tags"].(string)
+			if !ok {
+				continue
+			}
+			for _, tag := range strings.Split(raw, ",") {
+				if tag == "" || seen[tag] {
+					continue
+				}
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+		return tags, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func runMemoryAdd(cmd *cobra.Command, ctx *cmdctx.Context, content, tags, taskID string) error {
+	body := map[string]string{
+		"content": content,
+		"tags":    tags,
+		"task_id": taskID,
+	}
+
+	resp, err := ctx.API.Post("/memory", body)
+	if err != nil {
+		return err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return err
+	}
+
+	cmd.Printf("Created memory item: %s\n", result["id"])
+	return nil
+}
+
+func runMemoryQuery(cmd *cobra.Command, ctx *cmdctx.Context, query, tags string) error {
+	path := "/memory"
+	params := url.Values{}
+	if query != "" {
+		params.Set("q", query)
+	}
+	if tags != "" {
+		params.Set("tags", tags)
+	}
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := ctx.API.Get(path)
+	if err != nil {
+		return err
+	}
+
+	var items []map[string]interface{}
+	if err := json.Unmarshal(resp, &items); err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		cmd.Println("No memory items found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTASK\tCONTENT\tTAGS")
+	for _, item := range items {
+		id := truncateID(item["id"].(string))
+		taskID := ""
+		if tid, ok := item["task_id"].(string); ok {
+			taskID = truncateID(tid)
+		}
+		content := truncate(item["content"].(string), 50)
+		tags := ""
+		if t, ok := item["tags"].(string); ok {
+			tags = t
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", id, taskID, content, tags)
+	}
+	w.Flush()
+	return nil
+}
+
+// truncateID shortens a UUID-style ID to its first 8 characters for
+// compact table display.
+func truncateID(id string) string {
+	if len(id) <= 8 {
+		return id
+	}
+	return id[:8]
+}
+
+// truncate shortens s to maxLen characters, appending "..." when it had
+// to cut content off.
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
@@ -0,0 +1,118 @@
+// Package task wires up `neona task` and its subcommands for listing
+// and inspecting tasks tracked by the daemon.
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/spf13/cobra"
+)
+
+// NewTaskCmd builds the `neona task` command group.
+func NewTaskCmd(ctx *cmdctx.Context) *cobra.Command {
+	taskCmd := &cobra.Command{
+		Use:   "task",
+		Short: "Manage tasks",
+	}
+
+	listCmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List recent tasks",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTaskList(cmd, ctx)
+		},
+	}
+
+	getCmd := &cobra.Command{
+		Use:          "get <task-id>",
+		Short:        "Show a single task's details",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTaskGet(cmd, ctx, args[0])
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return completeTaskIDs(ctx, toComplete)
+		},
+	}
+
+	taskCmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeTaskIDs(ctx, toComplete)
+	}
+
+	taskCmd.AddCommand(listCmd, getCmd)
+	return taskCmd
+}
+
+// completeTaskIDs asks the daemon for recent task IDs, for `neona task
+// <TAB>` and `neona task get <TAB>`.
+func completeTaskIDs(ctx *cmdctx.Context, toComplete string) ([]string, cobra.ShellCompDirective) {
+	resp, err := ctx.API.Get("/tasks")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var tasks []map[string]interface{}
+	if err := json.Unmarshal(resp, &tasks); err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var ids []string
+	for _, t := range tasks {
+		id, ok := t["id"].(string)
+		if !ok || !strings.HasPrefix(id, toComplete) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+func runTaskList(cmd *cobra.Command, ctx *cmdctx.Context) error {
+	resp, err := ctx.API.Get("/tasks")
+	if err != nil {
+		return err
+	}
+
+	var tasks []map[string]interface{}
+	if err := json.Unmarshal(resp, &tasks); err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		cmd.Println("No tasks found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATUS\tTITLE")
+	for _, t := range tasks {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t["id"], t["status"], t["title"])
+	}
+	w.Flush()
+	return nil
+}
+
+func runTaskGet(cmd *cobra.Command, ctx *cmdctx.Context, taskID string) error {
+	resp, err := ctx.API.Get("/tasks/" + taskID)
+	if err != nil {
+		return err
+	}
+
+	var t map[string]interface{}
+	if err := json.Unmarshal(resp, &t); err != nil {
+		return err
+	}
+
+	for _, key := range []string{"id", "status", "title", "created_at"} {
+		if v, ok := t[key]; ok {
+			cmd.Printf("%s: %v\n", key, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Package tui wires up `neona tui`, the interactive terminal UI.
+package tui
+
+import (
+	"fmt"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/fentz26/neona/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// NewTUICmd builds the `neona tui` command.
+func NewTUICmd(ctx *cmdctx.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:          "tui",
+		Short:        "Launch the interactive TUI",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunTUI(ctx)
+		},
+	}
+}
+
+// RunTUI launches the TUI against ctx.API's address. It is also the
+// root command's default action when invoked with no subcommand.
+func RunTUI(ctx *cmdctx.Context) error {
+	app := tui.New(ctx.API.Addr)
+	if err := app.Run(); err != nil {
+		return fmt.Errorf("TUI error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Package uninstall wires up `neona uninstall`.
+package uninstall
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	"github.com/spf13/cobra"
+)
+
+// NewUninstallCmd builds the `neona uninstall` command.
+func NewUninstallCmd(ctx *cmdctx.Context) *cobra.Command {
+	var (
+		fullUninstall bool
+		keepData      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:          "uninstall",
+		Short:        "Uninstall Neona CLI and optionally remove data",
+		Long:         `Uninstall the Neona CLI binary and optionally remove the configuration/data directory (~/.neona).`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUninstall(cmd, ctx, fullUninstall, keepData)
+		},
+	}
+
+	cmd.Flags().BoolVar(&fullUninstall, "full", false, "Remove both binary and all data (~/.neona) without prompting")
+	cmd.Flags().BoolVar(&keepData, "keep-data", false, "Remove binary but keep data (skip prompt)")
+
+	return cmd
+}
+
+func runUninstall(cmd *cobra.Command, ctx *cmdctx.Context, fullUninstall, keepData bool) error {
+	binPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to determine executable path: %w", err)
+	}
+
+	// Resolve symlink if possible, just for display/info.
+	// We want to delete the actual file we are running.
+	// If it was a symlink, os.Executable usually returns the target.
+	if evalPath, err := filepath.EvalSymlinks(binPath); err == nil {
+		binPath = evalPath
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to get home directory: %w", err)
+	}
+	dataDir := filepath.Join(homeDir, ".neona")
+
+	var removeData bool
+
+	switch {
+	case fullUninstall:
+		removeData = true
+	case keepData:
+		removeData = false
+	default:
+		cmd.Println("⚠️  Neona Uninstaller")
+		cmd.Printf("   Binary Location: %s\n", binPath)
+		cmd.Printf("   Data Location:   %s\n", dataDir)
+		cmd.Println("")
+
+		reader := bufio.NewReader(ctx.In)
+
+		cmd.Print("Are you sure you want to uninstall Neona? [y/N]: ")
+		confirm, _ := reader.ReadString('\n')
+		if strings.ToLower(strings.TrimSpace(confirm)) != "y" {
+			cmd.Println("Uninstall aborted.")
+			return nil
+		}
+
+		cmd.Printf("Do you also want to delete all data (skills, agents, logs, etc.) in %s? [y/N]: ", dataDir)
+		dataConfirm, _ := reader.ReadString('\n')
+		if strings.ToLower(strings.TrimSpace(dataConfirm)) == "y" {
+			removeData = true
+		}
+	}
+
+	cmd.Println("\n🗑️  Uninstalling...")
+
+	if removeData {
+		cmd.Printf("   Removing data directory (%s)... ", dataDir)
+		if err := os.RemoveAll(dataDir); err != nil {
+			cmd.Printf("Failed: %v\n", err)
+		} else {
+			cmd.Println("Done")
+		}
+	} else {
+		cmd.Println("   Keeping data directory.")
+	}
+
+	cmd.Printf("   Removing binary (%s)... ", binPath)
+	if err := os.Remove(binPath); err != nil {
+		cmd.Printf("Failed: %v\n", err)
+		if os.IsPermission(err) {
+			cmd.Println("   ❌ Permission denied. You might need to run this command with 'sudo'.")
+			cmd.Println("   Try: sudo neona uninstall")
+		}
+	} else {
+		cmd.Println("Done")
+	}
+
+	cmd.Println("\n✅ Neona has been uninstalled.")
+	return nil
+}
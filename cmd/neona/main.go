@@ -4,35 +4,66 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/fentz26/neona/cmd/neona/cmdctx"
+	authcmd "github.com/fentz26/neona/cmd/neona/commands/auth"
+	completioncmd "github.com/fentz26/neona/cmd/neona/commands/completion"
+	daemoncmd "github.com/fentz26/neona/cmd/neona/commands/daemon"
+	logcmd "github.com/fentz26/neona/cmd/neona/commands/log"
+	memorycmd "github.com/fentz26/neona/cmd/neona/commands/memory"
+	taskcmd "github.com/fentz26/neona/cmd/neona/commands/task"
+	tuicmd "github.com/fentz26/neona/cmd/neona/commands/tui"
+	uninstallcmd "github.com/fentz26/neona/cmd/neona/commands/uninstall"
+	"github.com/fentz26/neona/internal/apiclient"
 	"github.com/spf13/cobra"
 )
 
-var rootCmd = &cobra.Command{
-	Use:   "neona",
-	Short: "Neona - AI Control Plane CLI",
-	Long:  `Neona is a CLI-centric AI Control Plane that coordinates multiple AI tools under shared rules, knowledge, and policy.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		// Default to running TUI
-		return runTUI(cmd, args)
-	},
-}
+func newRootCmd() *cobra.Command {
+	var apiAddr string
 
-var (
-	apiAddr string
-)
+	ctx := &cmdctx.Context{Out: os.Stdout, In: os.Stdin}
+
+	rootCmd := &cobra.Command{
+		Use:          "neona",
+		Short:        "Neona - AI Control Plane CLI",
+		Long:         `Neona is a CLI-centric AI Control Plane that coordinates multiple AI tools under shared rules, knowledge, and policy.`,
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			ctx.Config.APIAddr = apiAddr
+			ctx.API = apiclient.New(apiAddr)
+			// ctx.Auth is left nil here: building it touches the
+			// credential store (a keyring round trip, or a session file
+			// that may be corrupt), which commands that don't need
+			// authentication shouldn't have to pay for or fail on. The
+			// auth package builds and caches it on ctx lazily instead.
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Default to running TUI
+			return tuicmd.RunTUI(ctx)
+		},
+	}
 
-func init() {
 	rootCmd.PersistentFlags().StringVar(&apiAddr, "api", "http://127.0.0.1:7466", "API server address")
 
-	// Add subcommands
-	rootCmd.AddCommand(daemonCmd)
-	rootCmd.AddCommand(taskCmd)
-	rootCmd.AddCommand(memoryCmd)
-	rootCmd.AddCommand(tuiCmd)
+	rootCmd.AddCommand(daemoncmd.NewDaemonCmd(ctx))
+	rootCmd.AddCommand(taskcmd.NewTaskCmd(ctx))
+	rootCmd.AddCommand(memorycmd.NewMemoryCmd(ctx))
+	rootCmd.AddCommand(tuicmd.NewTUICmd(ctx))
+	rootCmd.AddCommand(logcmd.NewLogCmd(ctx))
+	rootCmd.AddCommand(uninstallcmd.NewUninstallCmd(ctx))
+	rootCmd.AddCommand(authcmd.NewAuthCmd(ctx))
+
+	// Keep `neona login`/`neona logout` as direct root aliases.
+	rootCmd.AddCommand(authcmd.NewDirectLoginCmd(ctx))
+	rootCmd.AddCommand(authcmd.NewDirectLogoutCmd(ctx))
+
+	rootCmd.AddCommand(completioncmd.NewCompletionCmd(rootCmd))
+
+	return rootCmd
 }
 
 func main() {
-	if err := rootCmd.Execute(); err != nil {
+	if err := newRootCmd().Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -0,0 +1,123 @@
+// Package apiclient is the CLI's HTTP client for talking to the Neona
+// daemon's control-plane API.
+package apiclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fentz26/neona/internal/logging"
+)
+
+// Client talks to a single daemon at Addr.
+type Client struct {
+	Addr string
+	http *http.Client
+}
+
+// New builds a Client pointed at addr, e.g. "http://127.0.0.1:7466".
+func New(addr string) *Client {
+	return &Client{Addr: addr, http: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Get issues a GET request to path (relative to Addr) and returns the
+// raw response body.
+func (c *Client) Get(path string) ([]byte, error) {
+	return c.do(http.MethodGet, path, nil)
+}
+
+// Post JSON-encodes body and issues a POST request to path, returning
+// the raw response body.
+func (c *Client) Post(path string, body interface{}) ([]byte, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request body: %w", err)
+	}
+	return c.do(http.MethodPost, path, bytes.NewReader(data))
+}
+
+// StreamLogs opens a long-lived SSE connection to the daemon's
+// /logs/stream endpoint and invokes onRecord for each record received.
+// It blocks until the stream ends, returning the error that ended it
+// (nil if the server closed it cleanly). lastEventID resumes the
+// stream after a previously-seen record ID, via the standard SSE
+// Last-Event-ID header; pass 0 to have the daemon replay its whole
+// retained buffer (subject to params) before the live tail.
+func (c *Client) StreamLogs(ctx context.Context, params url.Values, lastEventID uint64, onRecord func(logging.Record)) error {
+	path := "/logs/stream"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Addr+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatUint(lastEventID, 10))
+	}
+
+	// The stream is meant to stay open indefinitely, unlike c.http's
+	// request-response calls, so it gets its own client with no fixed
+	// timeout; ctx is what bounds its lifetime.
+	resp, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach daemon at %s: %w", c.Addr, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("daemon returned %s: %s", resp.Status, string(data))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var rec logging.Record
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &rec); err != nil {
+			continue
+		}
+		onRecord(rec)
+	}
+	return scanner.Err()
+}
+
+func (c *Client) do(method, path string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.Addr+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach daemon at %s: %w", c.Addr, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("daemon returned %s: %s", resp.Status, string(data))
+	}
+	return data, nil
+}
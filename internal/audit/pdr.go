@@ -0,0 +1,69 @@
+// Package audit records process decisions — audit events for task
+// lifecycle and daemon state changes — so they can be reviewed or
+// replayed later.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fentz26/neona/internal/store"
+)
+
+// PDRWriter persists Process Decision Records to a store.DB. Today the
+// only record is the in-flight task checkpoint, and each write replaces
+// the previous one.
+type PDRWriter struct {
+	store *store.DB
+}
+
+// NewPDRWriter builds a PDRWriter backed by s.
+func NewPDRWriter(s *store.DB) *PDRWriter {
+	return &PDRWriter{store: s}
+}
+
+// Checkpoint is a snapshot of the tasks that were still running when
+// it was written.
+type Checkpoint struct {
+	TaskIDs   []string  `json:"task_ids"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CheckpointInFlight persists taskIDs — the tasks still running at
+// shutdown — so the next daemon startup can find them via
+// RestoreInFlight instead of treating them as silently abandoned.
+func (p *PDRWriter) CheckpointInFlight(ctx context.Context, taskIDs []string) error {
+	data, err := json.Marshal(Checkpoint{TaskIDs: taskIDs, CreatedAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+	if err := p.store.WriteCheckpoint(data); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// RestoreInFlight reads back the checkpoint written by the previous
+// run's CheckpointInFlight, if any, and clears it so a later run
+// doesn't see the same task IDs again. It returns a nil Checkpoint if
+// there was nothing to restore.
+func (p *PDRWriter) RestoreInFlight() (*Checkpoint, error) {
+	data, err := p.store.ReadCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	if err := p.store.ClearCheckpoint(); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
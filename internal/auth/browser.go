@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Login performs the interactive browser-based OAuth flow: it opens the
+// user's default browser to the IdP's authorize endpoint with a random
+// state parameter (no PKCE code challenge is sent), binds a localhost
+// callback server, and waits for the
+// resulting authorization code to be exchanged for a session.
+func (m *Manager) Login(ctx context.Context) (*Session, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind local callback server: %w", err)
+	}
+	defer listener.Close()
+
+	state, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+	authorizeURL := fmt.Sprintf("%s/authorize?client_id=neona-cli&state=%s&redirect_uri=%s",
+		DefaultIssuer, state, url.QueryEscape(redirectURI))
+
+	resultCh := make(chan callbackResult, 1)
+	server := &http.Server{Handler: newCallbackHandler(state, resultCh)}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err := openBrowser(authorizeURL); err != nil {
+		return nil, fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resultCh:
+		if result.err != nil {
+			return nil, result.err
+		}
+		session, err := exchangeCode(ctx, result.code, redirectURI)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.setSession(session); err != nil {
+			return nil, err
+		}
+		return session, nil
+	}
+}
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+func newCallbackHandler(wantState string, resultCh chan<- callbackResult) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("state") != wantState {
+			resultCh <- callbackResult{err: fmt.Errorf("OAuth state mismatch")}
+			http.Error(w, "state mismatch", http.StatusBadRequest)
+			return
+		}
+		if errMsg := q.Get("error"); errMsg != "" {
+			resultCh <- callbackResult{err: fmt.Errorf("authorization failed: %s", errMsg)}
+			http.Error(w, errMsg, http.StatusBadRequest)
+			return
+		}
+
+		resultCh <- callbackResult{code: q.Get("code")}
+		fmt.Fprintln(w, "Signed in. You can close this tab and return to your terminal.")
+	})
+}
+
+// exchangeCode trades an authorization code for a Session by posting
+// to the IdP's token endpoint, the same way pollOnce does for the
+// device flow.
+func exchangeCode(ctx context.Context, code, redirectURI string) (*Session, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DefaultIssuer+"/token",
+		strings.NewReader(url.Values{
+			"grant_type":   {"authorization_code"},
+			"code":         {code},
+			"client_id":    {"neona-cli"},
+			"redirect_uri": {redirectURI},
+		}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err == nil && body.Error != "" {
+			return nil, fmt.Errorf("token exchange failed: %s", body.Error)
+		}
+		return nil, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	var session Session
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+	return &session, nil
+}
+
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func openBrowser(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
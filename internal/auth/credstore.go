@@ -0,0 +1,49 @@
+package auth
+
+import "fmt"
+
+// CredentialStore persists and retrieves the signed-in Session. Manager
+// delegates all persistence to one of these so the OS keyring, the
+// encrypted-file fallback, and "don't persist at all" are
+// interchangeable.
+type CredentialStore interface {
+	// Name identifies the backend, e.g. in `neona auth migrate-store` output.
+	Name() string
+	// Load returns the persisted session, or (nil, nil) if none exists.
+	Load() (*Session, error)
+	// Save persists session, overwriting any existing one.
+	Save(session *Session) error
+	// Delete removes any persisted session.
+	Delete() error
+}
+
+// storeKind is the set of values accepted by --credential-store.
+type storeKind string
+
+const (
+	storeAuto    storeKind = ""
+	storeFile    storeKind = "file"
+	storeKeyring storeKind = "keyring"
+	storeNone    storeKind = "none"
+)
+
+// NewCredentialStore builds the CredentialStore for the requested kind.
+// storeAuto picks the keyring when one is available on the host,
+// falling back to the encrypted file store otherwise.
+func NewCredentialStore(kind string) (CredentialStore, error) {
+	switch storeKind(kind) {
+	case storeFile:
+		return newFileCredentialStore()
+	case storeKeyring:
+		return newKeyringCredentialStore()
+	case storeNone:
+		return newNoneCredentialStore(), nil
+	case storeAuto:
+		if ks, err := newKeyringCredentialStore(); err == nil && ks.available() {
+			return ks, nil
+		}
+		return newFileCredentialStore()
+	default:
+		return nil, fmt.Errorf("unknown --credential-store %q (want file, keyring, or none)", kind)
+	}
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileCredentialStore is the fallback backend for hosts with no usable
+// OS keyring. The session is AES-GCM encrypted at rest with a key kept
+// alongside it at 0600; this is meant to keep a casual `cat` or backup
+// tool from leaking tokens, not to resist an attacker with local root.
+type fileCredentialStore struct {
+	sessionPath string
+	keyPath     string
+}
+
+func newFileCredentialStore() (*fileCredentialStore, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+	dir := filepath.Join(homeDir, ".neona")
+	return &fileCredentialStore{
+		sessionPath: filepath.Join(dir, "session.enc"),
+		keyPath:     filepath.Join(dir, ".credkey"),
+	}, nil
+}
+
+func (f *fileCredentialStore) Name() string { return "file" }
+
+func (f *fileCredentialStore) Load() (*Session, error) {
+	ciphertext, err := os.ReadFile(f.sessionPath)
+	if os.IsNotExist(err) {
+		return migrateLegacyPlaintext(f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := f.loadKey()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decrypt(key, ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt session: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (f *fileCredentialStore) Save(session *Session) error {
+	if err := os.MkdirAll(filepath.Dir(f.sessionPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+
+	key, err := f.loadOrCreateKey()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	ciphertext, err := encrypt(key, data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt session: %w", err)
+	}
+
+	return os.WriteFile(f.sessionPath, ciphertext, 0o600)
+}
+
+func (f *fileCredentialStore) Delete() error {
+	if err := os.Remove(f.sessionPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (f *fileCredentialStore) loadKey() ([]byte, error) {
+	key, err := os.ReadFile(f.keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encryption key: %w", err)
+	}
+	return key, nil
+}
+
+func (f *fileCredentialStore) loadOrCreateKey() ([]byte, error) {
+	if key, err := os.ReadFile(f.keyPath); err == nil {
+		return key, nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate encryption key: %w", err)
+	}
+	if err := os.WriteFile(f.keyPath, key, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to persist encryption key: %w", err)
+	}
+	return key, nil
+}
+
+// migrateLegacyPlaintext re-saves a pre-keyring plaintext session.json
+// as the encrypted session.enc, then removes the plaintext file. Only
+// the file store runs this migration: when the keyring backend is in
+// use, a legacy session.json is not read.
+func migrateLegacyPlaintext(f *fileCredentialStore) (*Session, error) {
+	legacyPath := filepath.Join(filepath.Dir(f.sessionPath), "session.json")
+	data, err := os.ReadFile(legacyPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("failed to parse legacy session: %w", err)
+	}
+
+	if err := f.Save(&session); err != nil {
+		return nil, fmt.Errorf("failed to migrate legacy session: %w", err)
+	}
+	os.Remove(legacyPath)
+
+	return &session, nil
+}
+
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService/keyringUser identify the single entry Neona stores in
+// the OS credential store (macOS Keychain, Windows Credential Manager,
+// or libsecret/kwallet on Linux — go-keyring picks whichever is
+// available for the host OS).
+const (
+	keyringService = "neona-cli"
+	keyringUser    = "session"
+)
+
+type keyringCredentialStore struct{}
+
+func newKeyringCredentialStore() (*keyringCredentialStore, error) {
+	return &keyringCredentialStore{}, nil
+}
+
+// available probes the keyring with a throwaway round-trip so callers
+// can fall back to the file store on hosts with no secret service
+// running (common on minimal Linux servers and containers).
+func (k *keyringCredentialStore) available() bool {
+	const probeUser = "probe"
+	if err := keyring.Set(keyringService, probeUser, "ok"); err != nil {
+		return false
+	}
+	keyring.Delete(keyringService, probeUser)
+	return true
+}
+
+func (k *keyringCredentialStore) Name() string { return "keyring" }
+
+func (k *keyringCredentialStore) Load() (*Session, error) {
+	data, err := keyring.Get(keyringService, keyringUser)
+	if err == keyring.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session from keyring: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, fmt.Errorf("failed to parse session from keyring: %w", err)
+	}
+	return &session, nil
+}
+
+func (k *keyringCredentialStore) Save(session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err := keyring.Set(keyringService, keyringUser, string(data)); err != nil {
+		return fmt.Errorf("failed to write session to keyring: %w", err)
+	}
+	return nil
+}
+
+func (k *keyringCredentialStore) Delete() error {
+	if err := keyring.Delete(keyringService, keyringUser); err != nil && err != keyring.ErrNotFound {
+		return fmt.Errorf("failed to delete session from keyring: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package auth
+
+// noneCredentialStore never persists a session: every `neona auth login`
+// is scoped to the current process. Used when --credential-store=none is
+// passed explicitly, e.g. in CI or other throwaway environments.
+type noneCredentialStore struct{}
+
+func newNoneCredentialStore() *noneCredentialStore { return &noneCredentialStore{} }
+
+func (n *noneCredentialStore) Name() string                { return "none" }
+func (n *noneCredentialStore) Load() (*Session, error)     { return nil, nil }
+func (n *noneCredentialStore) Save(session *Session) error { return nil }
+func (n *noneCredentialStore) Delete() error               { return nil }
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// DeviceCode is the response from the IdP's device authorization
+// endpoint (RFC 8628 section 3.2).
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// deviceTokenError mirrors the `error` field of RFC 8628 section 3.5
+// token responses.
+type deviceTokenError string
+
+const (
+	errAuthorizationPending deviceTokenError = "authorization_pending"
+	errSlowDown             deviceTokenError = "slow_down"
+	errExpiredToken         deviceTokenError = "expired_token"
+	errAccessDenied         deviceTokenError = "access_denied"
+)
+
+// StartDeviceAuth requests a device code from the IdP so the user can
+// approve the sign-in from another browser (e.g. on their phone).
+func StartDeviceAuth(ctx context.Context) (*DeviceCode, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DefaultIssuer+"/device/code",
+		strings.NewReader(url.Values{"client_id": {"neona-cli"}, "scope": {"offline_access"}}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device authorization endpoint returned %s", resp.Status)
+	}
+
+	var dc DeviceCode
+	if err := json.NewDecoder(resp.Body).Decode(&dc); err != nil {
+		return nil, fmt.Errorf("failed to parse device code response: %w", err)
+	}
+	if dc.Interval == 0 {
+		dc.Interval = 5
+	}
+	return &dc, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user approves (or
+// denies) the request, or the device code expires, honoring the
+// interval/slow_down/authorization_pending/expired_token/access_denied
+// semantics of RFC 8628 section 3.5.
+func PollDeviceToken(ctx context.Context, dc *DeviceCode) (*Session, error) {
+	interval := time.Duration(dc.Interval) * time.Second
+	deadline := time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+
+	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("device code expired before authorization was completed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		session, code, err := pollOnce(ctx, dc.DeviceCode)
+		if err != nil {
+			return nil, err
+		}
+		if session != nil {
+			return session, nil
+		}
+
+		switch deviceTokenError(code) {
+		case errAuthorizationPending:
+			continue
+		case errSlowDown:
+			interval += 5 * time.Second
+			continue
+		case errExpiredToken:
+			return nil, fmt.Errorf("device code expired before authorization was completed")
+		case errAccessDenied:
+			return nil, fmt.Errorf("authorization denied")
+		default:
+			return nil, fmt.Errorf("unexpected device token response: %s", code)
+		}
+	}
+}
+
+// pollOnce makes a single grant_type=device_code token request. A nil
+// session with a non-empty error code means "keep polling" (or a
+// terminal error the caller should surface); a non-nil session means
+// success.
+func pollOnce(ctx context.Context, deviceCode string) (*Session, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DefaultIssuer+"/token",
+		strings.NewReader(url.Values{
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+			"device_code": {deviceCode},
+			"client_id":   {"neona-cli"},
+		}.Encode()))
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to poll token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		var session Session
+		if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+			return nil, "", fmt.Errorf("failed to parse token response: %w", err)
+		}
+		return &session, "", nil
+	}
+
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, "", fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+	return nil, body.Error, nil
+}
+
+// LoginWithDevice runs the full device-authorization-grant flow: it
+// requests a device code, prints the user code and a scannable QR code
+// for the verification URI, polls for approval, and persists the
+// resulting session through the same path as LoginWithToken.
+func (m *Manager) LoginWithDevice(ctx context.Context, print func(string)) (*Session, error) {
+	dc, err := StartDeviceAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	print(fmt.Sprintf("│  To continue, open %s and enter code:\n", dc.VerificationURI))
+	print(fmt.Sprintf("│\n│      %s\n│\n", dc.UserCode))
+
+	if qr, err := qrcode.New(dc.VerificationURIComplete, qrcode.Medium); err == nil {
+		print(qr.ToSmallString(false))
+	}
+
+	session, err := PollDeviceToken(ctx, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.setSession(session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
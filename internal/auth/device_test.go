@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// withTestIssuer points DefaultIssuer at ts for the duration of the
+// test and restores it afterward.
+func withTestIssuer(t *testing.T, ts *httptest.Server) {
+	t.Helper()
+	prev := DefaultIssuer
+	DefaultIssuer = ts.URL
+	t.Cleanup(func() { DefaultIssuer = prev })
+}
+
+func TestPollDeviceTokenSucceedsAfterPending(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			json.NewEncoder(w).Encode(map[string]string{"error": "authorization_pending"})
+			return
+		}
+		json.NewEncoder(w).Encode(Session{
+			User:        &User{ID: "u1", Username: "alice"},
+			AccessToken: "tok",
+		})
+	}))
+	defer ts.Close()
+	withTestIssuer(t, ts)
+
+	dc := &DeviceCode{DeviceCode: "dc", Interval: 0, ExpiresIn: 60}
+	dc.Interval = 1 // PollDeviceToken waits Interval as given (the 5s default is applied only by StartDeviceAuth)
+
+	session, err := PollDeviceToken(context.Background(), dc)
+	if err != nil {
+		t.Fatalf("PollDeviceToken returned error: %v", err)
+	}
+	if session.User.Username != "alice" {
+		t.Fatalf("got user %q, want alice", session.User.Username)
+	}
+	if calls != 3 {
+		t.Fatalf("got %d poll calls, want 3", calls)
+	}
+}
+
+func TestPollDeviceTokenSlowDown(t *testing.T) {
+	var calls int
+	start := time.Now()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			json.NewEncoder(w).Encode(map[string]string{"error": "slow_down"})
+			return
+		}
+		json.NewEncoder(w).Encode(Session{User: &User{ID: "u1"}, AccessToken: "tok"})
+	}))
+	defer ts.Close()
+	withTestIssuer(t, ts)
+
+	dc := &DeviceCode{DeviceCode: "dc", Interval: 1, ExpiresIn: 60}
+	if _, err := PollDeviceToken(context.Background(), dc); err != nil {
+		t.Fatalf("PollDeviceToken returned error: %v", err)
+	}
+	// slow_down widens the interval by 5s before the next poll, so the
+	// whole exchange should take at least that long.
+	if elapsed := time.Since(start); elapsed < 5*time.Second {
+		t.Fatalf("slow_down did not widen the poll interval: took %v", elapsed)
+	}
+}
+
+func TestPollDeviceTokenExpiredAndDenied(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		code string
+	}{
+		{"expired", "expired_token"},
+		{"denied", "access_denied"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(map[string]string{"error": tc.code})
+			}))
+			defer ts.Close()
+			withTestIssuer(t, ts)
+
+			dc := &DeviceCode{DeviceCode: "dc", Interval: 1, ExpiresIn: 60}
+			if _, err := PollDeviceToken(context.Background(), dc); err == nil {
+				t.Fatalf("expected an error for %s", tc.code)
+			}
+		})
+	}
+}
+
+func TestPollDeviceTokenContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"error": "authorization_pending"})
+	}))
+	defer ts.Close()
+	withTestIssuer(t, ts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	dc := &DeviceCode{DeviceCode: "dc", Interval: 1, ExpiresIn: 60}
+	if _, err := PollDeviceToken(ctx, dc); err == nil {
+		t.Fatal("expected an error when ctx is already cancelled")
+	}
+}
@@ -0,0 +1,169 @@
+// Package auth manages the CLI's authentication session: signing in,
+// persisting the resulting credentials through a pluggable
+// CredentialStore, and exposing the current user to commands like
+// `neona whoami`.
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// DefaultIssuer is the Neona identity provider used for browser and
+// device-code OAuth flows. It's a var rather than a const so tests can
+// point it at a local httptest.Server.
+var DefaultIssuer = "https://auth.neona.dev"
+
+// User identifies the signed-in account.
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// Session is a signed-in user plus the tokens needed to keep them
+// signed in.
+type Session struct {
+	User         *User  `json:"user"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresAt    int64  `json:"expires_at"`
+}
+
+// Manager owns the current Session and delegates its persistence to a
+// CredentialStore.
+type Manager struct {
+	mu      sync.Mutex
+	store   CredentialStore
+	session *Session
+}
+
+// NewManager picks the best available CredentialStore (see
+// NewCredentialStore with the empty kind) and loads any existing
+// session from it.
+func NewManager() (*Manager, error) {
+	return NewManagerWithStore("")
+}
+
+// NewManagerWithStore builds a Manager backed by the named credential
+// store kind ("file", "keyring", "none", or "" for auto-detect), as
+// selected by the --credential-store flag.
+func NewManagerWithStore(kind string) (*Manager, error) {
+	store, err := NewCredentialStore(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{store: store}
+	session, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session: %w", err)
+	}
+	m.session = session
+
+	return m, nil
+}
+
+// StoreName identifies which CredentialStore backend is active, e.g.
+// for `neona auth migrate-store` progress output.
+func (m *Manager) StoreName() string {
+	return m.store.Name()
+}
+
+// IsAuthenticated reports whether a session is currently loaded.
+func (m *Manager) IsAuthenticated() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.session != nil
+}
+
+// GetUser returns the signed-in user, or nil if not authenticated.
+func (m *Manager) GetUser() *User {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session == nil {
+		return nil
+	}
+	return m.session.User
+}
+
+// GetSession returns the current session, or nil if not authenticated.
+func (m *Manager) GetSession() *Session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.session
+}
+
+// LoginWithToken authenticates using a pre-issued token JSON string, as
+// accepted by `neona auth login --token` and produced by `neona auth
+// export`.
+func (m *Manager) LoginWithToken(token string) (*Session, error) {
+	var session Session
+	if err := json.Unmarshal([]byte(token), &session); err != nil {
+		return nil, fmt.Errorf("invalid token payload: %w", err)
+	}
+	if session.User == nil || session.AccessToken == "" {
+		return nil, fmt.Errorf("token payload missing user or access_token")
+	}
+
+	if err := m.setSession(&session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Logout clears the current session, both in memory and in the
+// credential store.
+func (m *Manager) Logout() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.session = nil
+	return m.store.Delete()
+}
+
+// Export returns the current session as the same JSON payload accepted
+// by `neona auth login --token`, for `neona auth export`.
+func (m *Manager) Export() ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session == nil {
+		return nil, fmt.Errorf("not signed in")
+	}
+	return json.MarshalIndent(m.session, "", "  ")
+}
+
+// MigrateStore re-persists the current session into dest, then removes
+// it from the Manager's current store, for `neona auth migrate-store`.
+func (m *Manager) MigrateStore(dest CredentialStore) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.session == nil {
+		return fmt.Errorf("not signed in, nothing to migrate")
+	}
+	if err := dest.Save(m.session); err != nil {
+		return fmt.Errorf("failed to write session to %s: %w", dest.Name(), err)
+	}
+	if err := m.store.Delete(); err != nil {
+		return fmt.Errorf("failed to remove session from %s: %w", m.store.Name(), err)
+	}
+
+	m.store = dest
+	return nil
+}
+
+// setSession persists session through the active store and makes it
+// the active session.
+func (m *Manager) setSession(session *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.store.Save(session); err != nil {
+		return fmt.Errorf("failed to persist session: %w", err)
+	}
+
+	m.session = session
+	return nil
+}
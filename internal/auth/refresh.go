@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// refreshMargin is how long before expiry the background refresher
+// renews the access token, so long-running `neona daemon` sessions
+// don't expire mid-task.
+const refreshMargin = 5 * time.Minute
+
+// StartBackgroundRefresh renews the current session's access token
+// shortly before it expires, for as long as ctx is not cancelled. It
+// is meant for long-running processes like `neona daemon` that stay
+// up across many requests; one-shot commands like `neona auth login`
+// exit right after signing in, so there's nothing for a background
+// refresher to keep alive there. It is a no-op if no session is
+// currently loaded.
+func (m *Manager) StartBackgroundRefresh(ctx context.Context) {
+	session := m.GetSession()
+	if session == nil {
+		return
+	}
+	go m.refreshInBackground(ctx, session)
+}
+
+func (m *Manager) refreshInBackground(ctx context.Context, session *Session) {
+	for {
+		if session.RefreshToken == "" || session.ExpiresAt == 0 {
+			return
+		}
+
+		wait := time.Until(time.Unix(session.ExpiresAt, 0).Add(-refreshMargin))
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		refreshed, err := refreshToken(ctx, session.RefreshToken)
+		if err != nil {
+			// Leave the last-known-good session in place; the next
+			// authenticated request will surface the failure if the
+			// token has in fact expired.
+			return
+		}
+
+		if err := m.setSession(refreshed); err != nil {
+			return
+		}
+		session = refreshed
+	}
+}
+
+func refreshToken(ctx context.Context, refreshToken string) (*Session, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DefaultIssuer+"/token",
+		strings.NewReader(url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+			"client_id":     {"neona-cli"},
+		}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("refresh token endpoint returned %s", resp.Status)
+	}
+
+	var session Session
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return nil, fmt.Errorf("failed to parse refresh response: %w", err)
+	}
+	return &session, nil
+}
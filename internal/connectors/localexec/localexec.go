@@ -0,0 +1,119 @@
+// Package localexec runs tasks as local child processes and tracks
+// them so the daemon can terminate them cleanly on shutdown.
+package localexec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+)
+
+// trackedProc pairs a running child process with a channel that's
+// closed once Run's own goroutine has reaped it via cmd.Wait, so
+// Shutdown can wait for exit without reaping the process itself.
+type trackedProc struct {
+	process *os.Process
+	done    chan struct{}
+}
+
+// Connector runs tasks as child processes rooted at workDir, tracking
+// each one by task ID so Shutdown can terminate whatever is still
+// running.
+type Connector struct {
+	workDir string
+
+	mu    sync.Mutex
+	procs map[string]*trackedProc
+}
+
+// New builds a Connector that runs child processes in workDir.
+func New(workDir string) *Connector {
+	return &Connector{workDir: workDir, procs: make(map[string]*trackedProc)}
+}
+
+// Run starts name/args as a child process for taskID and tracks its
+// PID until it exits or Shutdown terminates it.
+func (c *Connector) Run(taskID, name string, args ...string) (*os.Process, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = c.workDir
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start task %s: %w", taskID, err)
+	}
+
+	tp := &trackedProc{process: cmd.Process, done: make(chan struct{})}
+	c.mu.Lock()
+	c.procs[taskID] = tp
+	c.mu.Unlock()
+
+	go func() {
+		cmd.Wait()
+		c.mu.Lock()
+		delete(c.procs, taskID)
+		c.mu.Unlock()
+		close(tp.done)
+	}()
+
+	return cmd.Process, nil
+}
+
+// InFlight returns the task IDs with a currently tracked child
+// process.
+func (c *Connector) InFlight() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]string, 0, len(c.procs))
+	for id := range c.procs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Shutdown sends SIGTERM to every tracked child process and waits for
+// Run's goroutine to reap them, escalating to SIGKILL for any still
+// running once ctx's deadline passes. It never calls Wait itself —
+// that's Run's job — so a process is only ever reaped once.
+func (c *Connector) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	procs := make([]*trackedProc, 0, len(c.procs))
+	for _, tp := range c.procs {
+		procs = append(procs, tp)
+	}
+	c.mu.Unlock()
+
+	if len(procs) == 0 {
+		return nil
+	}
+
+	for _, tp := range procs {
+		_ = tp.process.Signal(syscall.SIGTERM)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for _, tp := range procs {
+			<-tp.done
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		remaining := 0
+		for _, tp := range procs {
+			select {
+			case <-tp.done:
+				continue
+			default:
+			}
+			_ = tp.process.Kill()
+			remaining++
+		}
+		return fmt.Errorf("grace period expired, sent SIGKILL to %d remaining task process(es)", remaining)
+	}
+}
@@ -0,0 +1,85 @@
+package localexec
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestRunTracksAndUntracksPID(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep not available")
+	}
+
+	c := New(t.TempDir())
+	if _, err := c.Run("task-1", "sleep", "0.2"); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	inFlight := c.InFlight()
+	if len(inFlight) != 1 || inFlight[0] != "task-1" {
+		t.Fatalf("got InFlight %v, want [task-1]", inFlight)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(c.InFlight()) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("task-1 was never untracked after exiting")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestShutdownTerminatesRunningProcess(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep not available")
+	}
+
+	c := New(t.TempDir())
+	if _, err := c.Run("task-1", "sleep", "5"); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := c.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if inFlight := c.InFlight(); len(inFlight) != 0 {
+		t.Fatalf("expected no tasks in flight after Shutdown, got %v", inFlight)
+	}
+}
+
+func TestShutdownEscalatesToSIGKILLPastDeadline(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	c := New(t.TempDir())
+	// Ignores SIGTERM so Shutdown is forced to escalate to SIGKILL once
+	// ctx's deadline passes.
+	if _, err := c.Run("task-1", "sh", "-c", "trap '' TERM; sleep 1"); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Shutdown(ctx)
+	if err == nil {
+		t.Fatal("expected an error reporting the SIGKILL escalation")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Shutdown took %v, expected it to return shortly after the deadline", elapsed)
+	}
+}
+
+func TestShutdownWithNoTrackedProcessesIsNoop(t *testing.T) {
+	c := New(t.TempDir())
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown with nothing tracked: %v", err)
+	}
+}
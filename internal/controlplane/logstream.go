@@ -0,0 +1,129 @@
+// This file adds the live log-streaming endpoint to the control-plane
+// API; see Server.Handle for how routes are mounted on a Server.
+package controlplane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fentz26/neona/internal/logging"
+)
+
+// LogStreamHandler serves GET /logs/stream: a Server-Sent Events feed
+// of structured log records backed by hub, the daemon's in-memory
+// ring buffer of recent log records. NewServer does not mount it; the
+// daemon registers it on its Server with Server.Handle.
+//
+// Supported query parameters:
+//   - level:   only records at this severity or above (error, warn)
+//   - service: only records from this service
+//   - task:    only records tagged with this task ID
+//   - since:   only replay buffered records newer than this duration
+//     (e.g. "10m"), mirroring `neona log --since`
+//
+// Reconnecting clients should send the last record ID they saw as the
+// standard SSE Last-Event-ID request header; the handler replays
+// buffered records after that ID before switching to the live tail, so
+// a dropped connection doesn't lose records in between.
+type LogStreamHandler struct {
+	hub *logging.Hub
+}
+
+// NewLogStreamHandler builds a LogStreamHandler backed by hub.
+func NewLogStreamHandler(hub *logging.Hub) *LogStreamHandler {
+	return &LogStreamHandler{hub: hub}
+}
+
+func (h *LogStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	q := r.URL.Query()
+	level := q.Get("level")
+	service := q.Get("service")
+	task := q.Get("task")
+
+	var cutoff time.Time
+	if since := q.Get("since"); since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid since duration %q: %v", since, err), http.StatusBadRequest)
+			return
+		}
+		cutoff = time.Now().Add(-d)
+	}
+
+	var lastID uint64
+	if reconnect := r.Header.Get("Last-Event-ID"); reconnect != "" {
+		id, err := strconv.ParseUint(reconnect, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid Last-Event-ID %q: %v", reconnect, err), http.StatusBadRequest)
+			return
+		}
+		lastID = id
+	}
+
+	matches := func(rec logging.Record) bool {
+		if level != "" && !logging.LevelMatches(rec.Level, level) {
+			return false
+		}
+		if service != "" && !strings.EqualFold(rec.Service, service) {
+			return false
+		}
+		if task != "" && rec.TaskID != task {
+			return false
+		}
+		if !cutoff.IsZero() && rec.Time.Before(cutoff) {
+			return false
+		}
+		return true
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	live, cancel := h.hub.Subscribe()
+	defer cancel()
+
+	for _, rec := range h.hub.Since(lastID) {
+		if matches(rec) {
+			writeRecord(w, rec)
+		}
+		lastID = rec.ID
+	}
+	flusher.Flush()
+
+	ctx := r.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case rec := <-live:
+			if rec.ID <= lastID {
+				continue
+			}
+			lastID = rec.ID
+			if matches(rec) {
+				writeRecord(w, rec)
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+func writeRecord(w http.ResponseWriter, rec logging.Record) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\ndata: %s\n\n", rec.ID, data)
+}
@@ -0,0 +1,54 @@
+// Package controlplane implements the daemon's HTTP API server: the
+// route table plus the Service that backs it.
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Server is the daemon's HTTP API server. It owns the route table and
+// delegates the work behind each route to a Service.
+type Server struct {
+	service *Service
+	http    *http.Server
+	mux     *http.ServeMux
+}
+
+// NewServer builds a Server bound to addr, backed by service.
+func NewServer(service *Service, addr string) *Server {
+	mux := http.NewServeMux()
+	return &Server{
+		service: service,
+		mux:     mux,
+		http:    &http.Server{Addr: addr, Handler: mux},
+	}
+}
+
+// Handle registers an additional route on the server, e.g. the
+// log-streaming endpoint in logstream.go.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Start serves the API until the listener is closed by Shutdown, at
+// which point it returns nil rather than http.ErrServerClosed.
+func (s *Server) Start() error {
+	err := s.http.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown drains in-flight HTTP requests, then has the Service
+// checkpoint in-flight task state and terminate any still-running
+// task processes, all bounded by ctx.
+func (s *Server) Shutdown(ctx context.Context) error {
+	if err := s.http.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to drain HTTP connections: %w", err)
+	}
+	return s.service.Shutdown(ctx)
+}
@@ -0,0 +1,38 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fentz26/neona/internal/audit"
+	"github.com/fentz26/neona/internal/connectors/localexec"
+	"github.com/fentz26/neona/internal/store"
+)
+
+// Service holds the daemon's state and dependencies behind the HTTP
+// routes mounted on a Server.
+type Service struct {
+	store     *store.DB
+	pdr       *audit.PDRWriter
+	connector *localexec.Connector
+}
+
+// NewService builds a Service backed by s, pdr, and connector.
+func NewService(s *store.DB, pdr *audit.PDRWriter, connector *localexec.Connector) *Service {
+	return &Service{store: s, pdr: pdr, connector: connector}
+}
+
+// Shutdown checkpoints the tasks the connector still has in flight
+// through pdr, then has the connector SIGTERM (and, after ctx's
+// deadline, SIGKILL) their processes. Checkpointing first ensures a
+// restart has an accurate picture of what was running even if
+// terminating those processes is what ultimately blows the deadline.
+func (s *Service) Shutdown(ctx context.Context) error {
+	if err := s.pdr.CheckpointInFlight(ctx, s.connector.InFlight()); err != nil {
+		return fmt.Errorf("failed to checkpoint in-flight tasks: %w", err)
+	}
+	if err := s.connector.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to drain task processes: %w", err)
+	}
+	return nil
+}
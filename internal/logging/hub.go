@@ -0,0 +1,84 @@
+package logging
+
+import "sync"
+
+// Hub fans out Records to live subscribers and retains a bounded ring
+// buffer of recent history, so new subscribers (or ones resuming after
+// a dropped connection) can replay what they missed. It backs the
+// controlplane's /logs/stream endpoint.
+type Hub struct {
+	mu       sync.Mutex
+	capacity int
+	buf      []Record
+	nextID   uint64
+	subs     map[chan Record]struct{}
+}
+
+// NewHub creates a Hub retaining up to capacity Records.
+func NewHub(capacity int) *Hub {
+	return &Hub{capacity: capacity, subs: make(map[chan Record]struct{})}
+}
+
+// Publish assigns rec the next monotonically increasing ID, retains it
+// in the ring buffer, and delivers it to any live subscribers. It
+// returns rec with its assigned ID so the caller can persist it
+// elsewhere (e.g. to the log file) tagged with the same ID.
+func (h *Hub) Publish(rec Record) Record {
+	h.mu.Lock()
+	h.nextID++
+	rec.ID = h.nextID
+
+	h.buf = append(h.buf, rec)
+	if len(h.buf) > h.capacity {
+		h.buf = h.buf[len(h.buf)-h.capacity:]
+	}
+
+	subs := make([]chan Record, 0, len(h.subs))
+	for ch := range h.subs {
+		subs = append(subs, ch)
+	}
+	h.mu.Unlock()
+
+	for _, ch := range subs {
+		select {
+		case ch <- rec:
+		default:
+			// Slow subscriber; drop rather than block the writer.
+		}
+	}
+	return rec
+}
+
+// Subscribe registers a new live subscriber and returns a channel of
+// future Records plus a cancel function the subscriber must call when
+// it's done reading.
+func (h *Hub) Subscribe() (<-chan Record, func()) {
+	ch := make(chan Record, 256)
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	cancel := func() {
+		h.mu.Lock()
+		delete(h.subs, ch)
+		h.mu.Unlock()
+	}
+	return ch, cancel
+}
+
+// Since returns buffered Records with an ID greater than lastID, in
+// the order they were published. Pass 0 to get the entire retained
+// buffer.
+func (h *Hub) Since(lastID uint64) []Record {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	out := make([]Record, 0, len(h.buf))
+	for _, rec := range h.buf {
+		if rec.ID > lastID {
+			out = append(out, rec)
+		}
+	}
+	return out
+}
@@ -0,0 +1,177 @@
+// Package logging provides the structured, leveled logger shared by the
+// Neona daemon and CLI. Every event is JSON-encoded, one record per line,
+// so that `neona log` and other tooling can filter and parse reliably
+// instead of grepping free-form text.
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log record.
+type Level string
+
+const (
+	LevelDebug Level = "debug"
+	LevelInfo  Level = "info"
+	LevelWarn  Level = "warn"
+	LevelError Level = "error"
+)
+
+// Record is a single structured log event. It is JSON-encoded and
+// written one record per line, so the shape here is also the on-disk
+// wire format that `neona log` parses.
+type Record struct {
+	// ID is assigned by a Hub when the record is published to it, so
+	// streaming consumers can resume after a dropped connection. It is
+	// zero for records written by a Logger with no Hub attached.
+	ID        uint64    `json:"id,omitempty"`
+	Time      time.Time `json:"time"`
+	Level     Level     `json:"level"`
+	Service   string    `json:"service"`
+	Component string    `json:"component,omitempty"`
+	TaskID    string    `json:"task_id,omitempty"`
+	TraceID   string    `json:"trace_id,omitempty"`
+	Msg       string    `json:"msg"`
+}
+
+// Option sets optional fields on a Record before it is written.
+type Option func(*Record)
+
+// WithComponent tags the record with the subsystem that emitted it, e.g.
+// "scheduler" or "connector.localexec".
+func WithComponent(component string) Option {
+	return func(r *Record) { r.Component = component }
+}
+
+// WithTask tags the record with the task it pertains to.
+func WithTask(taskID string) Option {
+	return func(r *Record) { r.TaskID = taskID }
+}
+
+// WithTrace tags the record with a trace ID correlating it to a request.
+func WithTrace(traceID string) Option {
+	return func(r *Record) { r.TraceID = traceID }
+}
+
+// LevelMatches reports whether level passes the given level filter, the
+// semantics shared by `neona log --level` and the /logs/stream API:
+// "error" matches only errors, "warn"/"warning" matches warnings and
+// errors, and anything else (including an empty filter) matches
+// everything.
+func LevelMatches(level Level, filter string) bool {
+	switch strings.ToLower(filter) {
+	case "error":
+		return level == LevelError
+	case "warning", "warn":
+		return level == LevelWarn || level == LevelError
+	default:
+		return true
+	}
+}
+
+const (
+	defaultMaxSizeBytes = 10 * 1024 * 1024 // 10MB
+	defaultMaxBackups   = 5
+)
+
+// Logger writes structured Records as newline-delimited JSON.
+type Logger struct {
+	mu      sync.Mutex
+	service string
+	out     io.Writer
+	file    *rotatingFile // nil when writing to stderr/journald
+	hub     *Hub          // nil unless constructed with NewWithHub
+}
+
+// New creates a Logger for the given service name. When running under
+// systemd (JOURNAL_STREAM set), records are written to stderr so journald
+// captures them natively; otherwise they are written to LogPath() with
+// size-based rotation and retention.
+func New(service string) (*Logger, error) {
+	if UnderJournald() {
+		return &Logger{service: service, out: os.Stderr}, nil
+	}
+
+	path, err := LogPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	rf, err := openRotatingFile(path, defaultMaxSizeBytes, defaultMaxBackups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	return &Logger{service: service, out: rf, file: rf}, nil
+}
+
+// NewWithHub behaves like New but also publishes every record to hub,
+// so live subscribers such as the controlplane's /logs/stream endpoint
+// see them as they're written.
+func NewWithHub(service string, hub *Hub) (*Logger, error) {
+	l, err := New(service)
+	if err != nil {
+		return nil, err
+	}
+	l.hub = hub
+	return l, nil
+}
+
+// LogPath returns the default structured log file location.
+func LogPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".neona", "neona.log"), nil
+}
+
+// UnderJournald reports whether the process was started by systemd, in
+// which case stdout/stderr are already wired into the journal.
+func UnderJournald() bool {
+	return os.Getenv("JOURNAL_STREAM") != ""
+}
+
+// Close releases the underlying log file, if any.
+func (l *Logger) Close() error {
+	if l.file == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+func (l *Logger) write(level Level, msg string, opts []Option) {
+	rec := Record{Time: time.Now(), Level: level, Service: l.service, Msg: msg}
+	for _, opt := range opts {
+		opt(&rec)
+	}
+	if l.hub != nil {
+		rec = l.hub.Publish(rec)
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.out.Write(data)
+}
+
+func (l *Logger) Debug(msg string, opts ...Option) { l.write(LevelDebug, msg, opts) }
+func (l *Logger) Info(msg string, opts ...Option)  { l.write(LevelInfo, msg, opts) }
+func (l *Logger) Warn(msg string, opts ...Option)  { l.write(LevelWarn, msg, opts) }
+func (l *Logger) Error(msg string, opts ...Option) { l.write(LevelError, msg, opts) }
@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+)
+
+// rotatingFile is an os.File that rolls over to a numbered backup once it
+// exceeds maxSizeBytes, keeping at most maxBackups old files around
+// (neona.log.1 is the most recent backup, neona.log.N the oldest).
+type rotatingFile struct {
+	path        string
+	maxSize     int64
+	maxBackups  int
+	file        *os.File
+	currentSize int64
+}
+
+func openRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &rotatingFile{
+		path:        path,
+		maxSize:     maxSize,
+		maxBackups:  maxBackups,
+		file:        f,
+		currentSize: info.Size(),
+	}, nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	if r.currentSize+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.currentSize += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+
+	for i := r.maxBackups - 1; i >= 1; i-- {
+		oldPath := fmt.Sprintf("%s.%d", r.path, i)
+		newPath := fmt.Sprintf("%s.%d", r.path, i+1)
+		if _, err := os.Stat(oldPath); err == nil {
+			if i+1 > r.maxBackups {
+				os.Remove(oldPath)
+			} else {
+				os.Rename(oldPath, newPath)
+			}
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.currentSize = 0
+	return nil
+}
+
+func (r *rotatingFile) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileRotatesOnceOverSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.log")
+
+	rf, err := openRotatingFile(path, 10, 3)
+	if err != nil {
+		t.Fatalf("openRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	if _, err := rf.Write([]byte("12345")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Fatalf("expected no backup yet, got err=%v", err)
+	}
+
+	// This write pushes currentSize (5) + len(p) (6) over maxSize (10),
+	// so it should rotate the existing content into test.log.1 first.
+	if _, err := rf.Write([]byte("678901")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Fatalf("expected test.log.1 to exist after rotation: %v", err)
+	}
+	backup, err := os.ReadFile(path + ".1")
+	if err != nil {
+		t.Fatalf("ReadFile backup: %v", err)
+	}
+	if string(backup) != "12345" {
+		t.Fatalf("backup content = %q, want %q", backup, "12345")
+	}
+
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile current: %v", err)
+	}
+	if string(current) != "678901" {
+		t.Fatalf("current content = %q, want %q", current, "678901")
+	}
+}
+
+func TestRotatingFileKeepsAtMostMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.log")
+
+	rf, err := openRotatingFile(path, 1, 2)
+	if err != nil {
+		t.Fatalf("openRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	// Each write exceeds maxSize (1 byte), forcing a rotation before it
+	// lands, so after N writes there should be at most maxBackups (2)
+	// backup files plus the current one.
+	for i := 0; i < 5; i++ {
+		if _, err := rf.Write([]byte("xx")); err != nil {
+			t.Fatalf("Write %d: %v", i, err)
+		}
+	}
+
+	for _, suffix := range []string{".1", ".2"} {
+		if _, err := os.Stat(path + suffix); err != nil {
+			t.Fatalf("expected backup %s to exist: %v", suffix, err)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatalf("expected no backup beyond maxBackups, got err=%v", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Package store persists the daemon's control-plane state — currently
+// just the task checkpoint written on shutdown — to a file on disk so
+// it survives a daemon restart.
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DB is the daemon's persistent store, backed by a file at path.
+type DB struct {
+	mu   sync.Mutex
+	path string
+}
+
+// New opens the store at path, creating its parent directory and an
+// empty file if neither exists yet.
+func New(path string) (*DB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to initialize store at %s: %w", path, err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to stat store at %s: %w", path, err)
+	}
+	return &DB{path: path}, nil
+}
+
+// Close releases the store. It exists for symmetry with New and future
+// backends that hold an open handle; the file backend has nothing to
+// release.
+func (d *DB) Close() error {
+	return nil
+}
+
+// checkpointPath is where WriteCheckpoint persists data, alongside the
+// main store file.
+func (d *DB) checkpointPath() string {
+	return d.path + ".checkpoint"
+}
+
+// ReadCheckpoint returns the most recently written checkpoint, or nil
+// if none exists (e.g. first run, or one already removed by
+// ClearCheckpoint).
+func (d *DB) ReadCheckpoint() ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data, err := os.ReadFile(d.checkpointPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	return data, nil
+}
+
+// ClearCheckpoint removes the checkpoint once its contents have been
+// consumed on startup, so a later run doesn't replay stale task IDs
+// from a checkpoint that's already been acted on.
+func (d *DB) ClearCheckpoint() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.Remove(d.checkpointPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to clear checkpoint: %w", err)
+	}
+	return nil
+}
+
+// WriteCheckpoint atomically persists data so it survives a daemon
+// restart, writing to a temp file and renaming over the previous
+// checkpoint so a crash mid-write can't leave a truncated one behind.
+func (d *DB) WriteCheckpoint(data []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tmp := d.checkpointPath() + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, d.checkpointPath()); err != nil {
+		return fmt.Errorf("failed to commit checkpoint: %w", err)
+	}
+	return nil
+}